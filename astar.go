@@ -0,0 +1,49 @@
+// Package astar implements the A* search algorithm over an arbitrary
+// weighted graph. Callers describe their graph by implementing Pather;
+// the grid-based backend in grid.go is a reference implementation built
+// on top of it.
+package astar
+
+// Pather is implemented by anything that can be searched with FindPath.
+// It lets FindPath operate over arbitrary weighted graphs - hex maps, nav
+// meshes, road networks, and so on - without any knowledge of how nodes
+// are represented or connected.
+type Pather interface {
+	// PathNeighbors returns the nodes directly reachable from this one.
+	PathNeighbors() []Pather
+	// PathNeighborCost returns the cost of moving from this node to a
+	// neighbor previously returned by PathNeighbors.
+	PathNeighborCost(to Pather) float64
+	// PathEstimatedCost returns an admissible heuristic estimate of the
+	// remaining cost from this node to the goal.
+	PathEstimatedCost(to Pather) float64
+}
+
+// node wraps a Pather with the bookkeeping FindPath needs during a search.
+// index is maintained by priorityQueue to support decrease-key via
+// heap.Fix; it is -1 whenever the node is not present in the heap.
+type node struct {
+	pather   Pather
+	cost     float64 // g: cost from the start node
+	priority float64 // f = g + h
+	parent   *node
+	index    int
+}
+
+// FindPath searches from start to end using A*, returning the path
+// (inclusive of both endpoints), its total cost, and whether the goal was
+// actually reached. It is a thin single-goal wrapper over FindPathAny -
+// see its doc comment for the search itself, including the maxCost and
+// best-effort fallback semantics.
+func FindPath(start, end Pather, maxCost float64) ([]Pather, float64, bool) {
+	ends := [1]Pather{end}
+	return FindPathAny(start, ends[:], maxCost)
+}
+
+func reconstructPath(n *node) []Pather {
+	path := []Pather{}
+	for cur := n; cur != nil; cur = cur.parent {
+		path = append([]Pather{cur.pather}, path...)
+	}
+	return path
+}
@@ -0,0 +1,131 @@
+package astar
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// legacyFindPath is the original O(n^2) implementation that scanned the open
+// list linearly to find the lowest-priority node and to check membership.
+// It is kept here only so BenchmarkFindPathLegacy and
+// TestFindPathHeapAllocatesFewerThanLegacy can demonstrate the improvement
+// from switching to a heap-based open list.
+func legacyFindPath(start, end Pather) ([]Pather, bool) {
+	openList := []*node{}
+	nodes := make(map[Pather]*node)
+	closedSet := make(map[Pather]bool)
+
+	startNode := &node{pather: start, cost: 0, priority: start.PathEstimatedCost(end)}
+	openList = append(openList, startNode)
+	nodes[start] = startNode
+
+	for len(openList) > 0 {
+		currentIndex := 0
+		for i, n := range openList {
+			if n.priority < openList[currentIndex].priority {
+				currentIndex = i
+			}
+		}
+		current := openList[currentIndex]
+
+		if current.pather == end {
+			return reconstructPath(current), true
+		}
+		openList = append(openList[:currentIndex], openList[currentIndex+1:]...)
+		closedSet[current.pather] = true
+
+		for _, neighbor := range current.pather.PathNeighbors() {
+			if closedSet[neighbor] {
+				continue
+			}
+
+			tentativeCost := current.cost + current.pather.PathNeighborCost(neighbor)
+
+			neighborNode, exists := nodes[neighbor]
+			if !exists {
+				neighborNode = &node{pather: neighbor}
+				nodes[neighbor] = neighborNode
+				openList = append(openList, neighborNode)
+			} else if tentativeCost >= neighborNode.cost {
+				continue
+			}
+
+			neighborNode.cost = tentativeCost
+			neighborNode.priority = tentativeCost + neighbor.PathEstimatedCost(end)
+			neighborNode.parent = current
+		}
+	}
+	return nil, false
+}
+
+// benchGrid500 builds a randomized 500x500 grid with the corners forced
+// walkable, large enough that the O(n^2) open-list scan dominates runtime.
+func benchGrid500() (start, end GridPather) {
+	const size = 500
+	board := make([][]int, size)
+	for y := range board {
+		board[y] = make([]int, size)
+		for x := range board[y] {
+			if rand.Float64() < 0.2 {
+				board[y][x] = Impassable
+			} else {
+				board[y][x] = 1
+			}
+		}
+	}
+	board[0][0] = 1
+	board[size-1][size-1] = 1
+
+	g := &Grid{}
+	g.SetBoard(board)
+	g.SetHeuristic(ManhattanDistance)
+
+	return GridPather{grid: g, point: Point{0, 0}}, GridPather{grid: g, point: Point{size - 1, size - 1}}
+}
+
+func BenchmarkFindPathLegacy(b *testing.B) {
+	start, end := benchGrid500()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		legacyFindPath(start, end)
+	}
+}
+
+// BenchmarkFindPathHeap repeats the same query on a fixed board, the
+// scenario the node/open-list/closed-set/nodes pools in pool.go target: a
+// game running many searches per second pays for a fresh node, heap slice,
+// and two maps on every legacyFindPath call, but only the first FindPath
+// call per pool generation, since later calls reuse their backing capacity.
+func BenchmarkFindPathHeap(b *testing.B) {
+	start, end := benchGrid500()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FindPath(start, end, 0)
+	}
+}
+
+// TestFindPathHeapAllocatesFewerThanLegacy pins down the improvement
+// BenchmarkFindPathHeap and BenchmarkFindPathLegacy only report: the pooled
+// FindPath must use meaningfully fewer allocations per search than the
+// unpooled legacyFindPath on the same board, not just run faster.
+func TestFindPathHeapAllocatesFewerThanLegacy(t *testing.T) {
+	start, end := benchGrid500()
+
+	// One warmup call each so the comparison reflects steady-state use
+	// (pools primed, board/heuristic caches hot) rather than first-call cost.
+	legacyFindPath(start, end)
+	FindPath(start, end, 0)
+
+	legacyAllocs := testing.AllocsPerRun(5, func() {
+		legacyFindPath(start, end)
+	})
+	heapAllocs := testing.AllocsPerRun(5, func() {
+		FindPath(start, end, 0)
+	})
+
+	if heapAllocs >= legacyAllocs {
+		t.Fatalf("pooled FindPath allocs/op = %v, want fewer than legacyFindPath's %v", heapAllocs, legacyAllocs)
+	}
+}
@@ -0,0 +1,152 @@
+package astar
+
+import (
+	"container/heap"
+	"math"
+)
+
+// direction is one frontier of a bidirectional search: a standard A* search
+// state, searching toward goal.
+type direction struct {
+	nodes    map[Pather]*node
+	closed   map[Pather]bool
+	openList *priorityQueue
+	goal     Pather
+}
+
+func newDirection(start, goal Pather) *direction {
+	d := &direction{
+		nodes:    nodesPool.Get().(map[Pather]*node),
+		closed:   closedSetPool.Get().(map[Pather]bool),
+		openList: openListPool.Get().(*priorityQueue),
+		goal:     goal,
+	}
+	*d.openList = (*d.openList)[:0]
+
+	startNode := acquireNode(start, 0, start.PathEstimatedCost(goal), nil)
+	d.nodes[start] = startNode
+	heap.Push(d.openList, startNode)
+	return d
+}
+
+func (d *direction) release() {
+	for _, n := range d.nodes {
+		n.reset()
+		nodePool.Put(n)
+	}
+	clear(d.nodes)
+	nodesPool.Put(d.nodes)
+	clear(d.closed)
+	closedSetPool.Put(d.closed)
+	*d.openList = (*d.openList)[:0]
+	openListPool.Put(d.openList)
+}
+
+// FindPathBidirectional searches simultaneously forward from start and
+// backward from end. Every node reached by both frontiers is a meeting
+// candidate, and the search stops once the lowest priority on either open
+// list is no less than the cheapest candidate's cost, so no cheaper path
+// can remain. Against an unreachable goal this proves there is no path much
+// faster than FindPath, since both frontiers only need to exhaust their own
+// small enclosed region rather than one search expanding the whole
+// reachable area alone.
+//
+// It assumes edges are reciprocal - whenever a is in b.PathNeighbors(), b is
+// in a.PathNeighbors() - since Pather only exposes forward neighbors and the
+// backward frontier walks them in reverse. The two directions need not cost
+// the same (the backward frontier always charges the true a->b edge cost,
+// not b->a), so this works with the Grid backend's weighted terrain. It
+// does not hold for arbitrary directed graphs with one-way edges, which
+// should use FindPath instead.
+func FindPathBidirectional(start, end Pather) ([]Pather, float64, bool) {
+	if start == end {
+		return []Pather{start}, 0, true
+	}
+
+	fwd := newDirection(start, end)
+	bwd := newDirection(end, start)
+	defer fwd.release()
+	defer bwd.release()
+
+	bestCost := math.Inf(1)
+	var meetingFwd, meetingBwd *node
+
+	considerMeeting := func(fwdNode, bwdNode *node) {
+		if total := fwdNode.cost + bwdNode.cost; total < bestCost {
+			bestCost = total
+			meetingFwd, meetingBwd = fwdNode, bwdNode
+		}
+	}
+
+	for fwd.openList.Len() > 0 && bwd.openList.Len() > 0 {
+		if max((*fwd.openList)[0].priority, (*bwd.openList)[0].priority) >= bestCost {
+			break
+		}
+
+		this, other := fwd, bwd
+		if (*bwd.openList)[0].priority < (*fwd.openList)[0].priority {
+			this, other = bwd, fwd
+		}
+
+		current := heap.Pop(this.openList).(*node)
+		this.closed[current.pather] = true
+
+		if otherNode, ok := other.nodes[current.pather]; ok && other.closed[current.pather] {
+			if this == fwd {
+				considerMeeting(current, otherNode)
+			} else {
+				considerMeeting(otherNode, current)
+			}
+		}
+
+		for _, neighbor := range current.pather.PathNeighbors() {
+			if this.closed[neighbor] {
+				continue
+			}
+
+			// The backward frontier walks a real forward edge in reverse, so
+			// its cost is neighbor->current, not current->neighbor - those
+			// differ whenever the two cells have different terrain cost.
+			edgeCost := current.pather.PathNeighborCost(neighbor)
+			if this == bwd {
+				edgeCost = neighbor.PathNeighborCost(current.pather)
+			}
+			tentativeCost := current.cost + edgeCost
+
+			neighborNode, exists := this.nodes[neighbor]
+			if !exists {
+				neighborNode = acquireNode(neighbor, tentativeCost, tentativeCost+neighbor.PathEstimatedCost(this.goal), current)
+				this.nodes[neighbor] = neighborNode
+				heap.Push(this.openList, neighborNode)
+			} else if tentativeCost < neighborNode.cost {
+				neighborNode.cost = tentativeCost
+				neighborNode.priority = tentativeCost + neighbor.PathEstimatedCost(this.goal)
+				neighborNode.parent = current
+				heap.Fix(this.openList, neighborNode.index)
+			}
+
+			// The frontiers can also touch before either side has settled
+			// (popped) the shared node, so every candidate is compared
+			// against the best meeting cost found so far, not just the
+			// first one encountered.
+			if otherNode, ok := other.nodes[neighbor]; ok {
+				if this == fwd {
+					considerMeeting(neighborNode, otherNode)
+				} else {
+					considerMeeting(otherNode, neighborNode)
+				}
+			}
+		}
+	}
+
+	if meetingFwd == nil {
+		return nil, 0, false
+	}
+
+	path := reconstructPath(meetingFwd)
+	backward := reconstructPath(meetingBwd)
+	for i := len(backward) - 2; i >= 0; i-- {
+		path = append(path, backward[i])
+	}
+	return path, bestCost, true
+}
@@ -0,0 +1,101 @@
+package astar
+
+import "testing"
+
+func TestFindPathBidirectionalMatchesFindPath(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1},
+		{1, Impassable, Impassable, Impassable, 1},
+		{1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{4, 0})
+	g.SetHeuristic(ManhattanDistance)
+
+	_, wantCost, wantFound := g.FindPath()
+	_, gotCost, gotFound := g.FindPathBidirectional()
+
+	if gotFound != wantFound {
+		t.Fatalf("found = %v, want %v", gotFound, wantFound)
+	}
+	if gotCost != wantCost {
+		t.Fatalf("cost = %v, want %v", gotCost, wantCost)
+	}
+}
+
+// TestFindPathBidirectionalIsland covers the case bidirectional search is
+// meant for: a goal sealed inside a small walkable pocket, unreachable from
+// the start. Both frontiers should exhaust their own small component and
+// report no path.
+func TestFindPathBidirectionalIsland(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, Impassable, 1, 1},
+		{1, 1, Impassable, 1, 1},
+		{Impassable, Impassable, Impassable, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{4, 2})
+	g.SetHeuristic(ManhattanDistance)
+
+	if _, _, found := g.FindPathBidirectional(); found {
+		t.Fatal("expected the island to be unreachable")
+	}
+}
+
+// TestFindPathBidirectionalWeightedTerrainMatchesFindPath guards against the
+// backward frontier charging the wrong direction's edge cost: on a board
+// where entering each cell costs a different amount, cost(a->b) and
+// cost(b->a) differ, so a backward search that reused the forward cost
+// would silently undercount.
+func TestFindPathBidirectionalWeightedTerrainMatchesFindPath(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{4, 2, 1},
+	})
+	g.SetStart(Point{2, 0})
+	g.SetEnd(Point{0, 0})
+	g.SetHeuristic(ManhattanDistance)
+
+	_, wantCost, wantFound := g.FindPath()
+	_, gotCost, gotFound := g.FindPathBidirectional()
+
+	if gotFound != wantFound {
+		t.Fatalf("found = %v, want %v", gotFound, wantFound)
+	}
+	if gotCost != wantCost {
+		t.Fatalf("cost = %v, want %v", gotCost, wantCost)
+	}
+}
+
+func TestFindPathBidirectionalSameStartAndEnd(t *testing.T) {
+	p := GridPather{grid: &Grid{}, point: Point{1, 1}}
+	path, cost, found := FindPathBidirectional(p, p)
+	if !found || cost != 0 || len(path) != 1 {
+		t.Fatalf("got (%v, %v, %v), want a single-point zero-cost path", path, cost, found)
+	}
+}
+
+// TestGridFindPathBidirectionalWithNoEndSet guards against a.ends[0] being
+// indexed before any end is configured: a Grid with no end set should report
+// found as false like FindPath does, not panic.
+func TestGridFindPathBidirectionalWithNoEndSet(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetHeuristic(ManhattanDistance)
+
+	path, cost, found := g.FindPathBidirectional()
+	if found {
+		t.Fatal("expected found = false with no end set")
+	}
+	if cost != 0 {
+		t.Fatalf("cost = %v, want 0", cost)
+	}
+	if len(path) != 1 || path[0] != g.start {
+		t.Fatalf("path = %v, want just the start", path)
+	}
+}
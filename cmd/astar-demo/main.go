@@ -0,0 +1,40 @@
+// Command astar-demo runs A* over a randomized grid and prints the result.
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/RegaliaXYZ/go-astar"
+)
+
+func main() {
+	// Example grid (1 = walkable, astar.Impassable = obstacle)
+	a := astar.Grid{}
+	var r_width, r_height int
+	for {
+		r_width = rand.IntN(20)
+		r_height = rand.IntN(20)
+		if r_width > 1 && r_height > 1 {
+			break
+		}
+	}
+
+	a.Randomize(r_width, r_height)
+	fmt.Println("------ RANDOMIZED BOARD ------")
+	a.PrettyPrint(nil)
+	fmt.Println("------ END OF BOARD ------")
+	fmt.Println("Searching for path...")
+	path, _, found := a.FindPath()
+	if found {
+		fmt.Println("Path found!")
+	} else {
+		fmt.Println("No path found...")
+	}
+	//fmt.Println(a.start, a.end)
+	fmt.Println("------ PRINTING FINAL BOARD ------ ")
+	a.PrettyPrint(path)
+	fmt.Println("------ END OF BOARD ------")
+	fmt.Println("Printing raw path taken.")
+	a.RawPath(path)
+}
@@ -0,0 +1,371 @@
+package astar
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// Point is a coordinate on a Grid.
+type Point struct {
+	X, Y int
+}
+
+// HeuristicFunc estimates the cost between two points on a Grid.
+type HeuristicFunc func(a, b Point) float64
+
+// ManhattanDistance is the sum of the absolute differences of the
+// coordinates, suitable for 4-connected grids.
+func ManhattanDistance(a, b Point) float64 {
+	return math.Abs(float64(a.X-b.X)) + math.Abs(float64(a.Y-b.Y))
+}
+
+// EuclideanDistance calculates the straight-line distance between two points.
+func EuclideanDistance(a, b Point) float64 {
+	return math.Sqrt(math.Pow(float64(a.X-b.X), 2) + math.Pow(float64(a.Y-b.Y), 2))
+}
+
+// OctileDistance is the cost of the shortest path between two points for a
+// grid that allows diagonal moves at cost sqrt(2): it takes the diagonal
+// steps it can and finishes the rest orthogonally.
+func OctileDistance(a, b Point) float64 {
+	dx := math.Abs(float64(a.X - b.X))
+	dy := math.Abs(float64(a.Y - b.Y))
+	return (dx + dy) + (math.Sqrt2-2)*math.Min(dx, dy)
+}
+
+// ChebyshevDistance is the minimum number of king moves between two points,
+// admissible for grids where diagonal and orthogonal moves cost the same.
+func ChebyshevDistance(a, b Point) float64 {
+	dx := math.Abs(float64(a.X - b.X))
+	dy := math.Abs(float64(a.Y - b.Y))
+	return math.Max(dx, dy)
+}
+
+// Movement selects which neighboring cells a GridPather considers adjacent.
+type Movement int
+
+const (
+	// FourConnected restricts movement to the four orthogonal directions.
+	FourConnected Movement = iota
+	// EightConnected additionally allows the four diagonal directions, at a
+	// cost of sqrt(2) per step.
+	EightConnected
+)
+
+var orthogonalOffsets = []Point{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+var diagonalOffsets = []Point{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+
+// Impassable marks a Grid cell that cannot be entered at any cost.
+const Impassable = -1
+
+// CostFunc computes the cost of moving from one Grid cell to an adjacent
+// one, letting callers express directional costs such as uphill penalties
+// or road bonuses. When nil, a Grid falls back to the cost of entering the
+// destination cell (its board value), scaled by sqrt(2) for diagonal moves.
+type CostFunc func(from, to Point) float64
+
+// Grid is a [][]int board searched via GridPather, the library's original
+// backend. Each cell holds the cost of entering it, except Impassable which
+// marks an obstacle. It exists as a thin adapter over the generic
+// Pather-based solver in astar.go.
+type Grid struct {
+	board              [][]int
+	heuristicFunc      HeuristicFunc
+	start              Point
+	ends               []Point
+	movement           Movement
+	allowCornerCutting bool
+	maxCost            float64
+	costFunc           CostFunc
+	useJPS             bool
+}
+
+// GridPather is the Pather implementation for a single cell of a Grid. It is
+// a plain value so that two GridPathers for the same cell compare equal,
+// which FindPath relies on to dedupe nodes.
+type GridPather struct {
+	grid  *Grid
+	point Point
+}
+
+func (g GridPather) PathNeighbors() []Pather {
+	offsets := orthogonalOffsets
+	if g.grid.movement == EightConnected {
+		offsets = append(append([]Point{}, orthogonalOffsets...), diagonalOffsets...)
+	}
+
+	neighbors := make([]Pather, 0, len(offsets))
+	for _, offset := range offsets {
+		p := Point{g.point.X + offset.X, g.point.Y + offset.Y}
+		if g.grid.blocked(p) {
+			continue
+		}
+
+		isDiagonal := offset.X != 0 && offset.Y != 0
+		if isDiagonal && !g.grid.allowCornerCutting {
+			corner1 := Point{g.point.X + offset.X, g.point.Y}
+			corner2 := Point{g.point.X, g.point.Y + offset.Y}
+			if g.grid.blocked(corner1) || g.grid.blocked(corner2) {
+				continue
+			}
+		}
+
+		neighbors = append(neighbors, GridPather{grid: g.grid, point: p})
+	}
+	return neighbors
+}
+
+func (g GridPather) PathNeighborCost(to Pather) float64 {
+	p := to.(GridPather).point
+	if g.grid.costFunc != nil {
+		return g.grid.costFunc(g.point, p)
+	}
+
+	cost := float64(g.grid.board[p.Y][p.X])
+	if p.X != g.point.X && p.Y != g.point.Y {
+		cost *= math.Sqrt2
+	}
+	return cost
+}
+
+func (g GridPather) PathEstimatedCost(to Pather) float64 {
+	return g.grid.heuristicFunc(g.point, to.(GridPather).point)
+}
+
+func (a *Grid) SetStart(start Point) {
+	a.start = start
+}
+
+// SetEnd sets a single goal, replacing any goals set by SetEnds.
+func (a *Grid) SetEnd(end Point) {
+	a.ends = []Point{end}
+}
+
+// SetEnds sets a set of equally acceptable goals - "walk to the nearest
+// door" rather than one specific tile. FindPath returns the shortest path
+// to whichever goal is reached first, using the minimum estimated cost to
+// any of them as its heuristic. JumpPointSearch and FindPathBidirectional
+// only support a single goal; see jumpPointSearchEnabled and
+// FindPathBidirectional.
+func (a *Grid) SetEnds(ends []Point) {
+	a.ends = ends
+}
+
+// SetBoard sets the cost of entering each cell, or Impassable to block it.
+// A board with any walkable cost other than 1 disables JumpPointSearch,
+// which falls back to ordinary neighbor expansion; see
+// SetUseJumpPointSearch.
+func (a *Grid) SetBoard(board [][]int) {
+	a.board = board
+}
+
+func (a *Grid) SetHeuristic(heuristic HeuristicFunc) {
+	a.heuristicFunc = heuristic
+}
+
+// SetMovement selects which neighboring cells are considered adjacent.
+func (a *Grid) SetMovement(movement Movement) {
+	a.movement = movement
+}
+
+// SetAllowCornerCutting controls whether a diagonal move is allowed when one
+// of the two orthogonally adjacent cells is blocked. It has no effect unless
+// movement is EightConnected.
+func (a *Grid) SetAllowCornerCutting(allow bool) {
+	a.allowCornerCutting = allow
+}
+
+// SetMaxCost caps how far FindPath will expand the search, in the same
+// units as PathNeighborCost (0 means unlimited). It is useful for games
+// where a player can click an unreachable tile and the character should
+// still walk to the closest reachable point rather than doing nothing.
+func (a *Grid) SetMaxCost(maxCost float64) {
+	a.maxCost = maxCost
+}
+
+// SetCostFunc overrides how the cost of each move is computed. Pass nil to
+// restore the default of using the destination cell's board value.
+func (a *Grid) SetCostFunc(costFunc CostFunc) {
+	a.costFunc = costFunc
+}
+
+// SetUseJumpPointSearch selects Jump Point Search, which prunes symmetric
+// paths on uniform-cost grids by only inserting jump points into the open
+// list instead of every neighbor. It requires EightConnected movement with
+// corner cutting forbidden, no CostFunc override, a uniform-cost board (every
+// walkable cell set to 1, since SetBoard/RandomizeWeighted is the normal way
+// to express weighted terrain and JPS's forced-neighbor rules assume a
+// uniform per-step cost), a single goal set via SetEnd, and no MaxCost -
+// a jump point is an all-or-nothing run to the next forced neighbor, so it
+// can't stop partway through to honor a budget the way ordinary neighbor
+// expansion can. It is ignored otherwise and Grid falls back to ordinary
+// neighbor expansion.
+func (a *Grid) SetUseJumpPointSearch(use bool) {
+	a.useJPS = use
+}
+
+func (a *Grid) jumpPointSearchEnabled() bool {
+	return a.useJPS && a.movement == EightConnected && !a.allowCornerCutting && a.costFunc == nil && len(a.ends) == 1 && a.maxCost == 0 && a.boardIsUniformCost()
+}
+
+// boardIsUniformCost reports whether every walkable cell costs exactly 1 to
+// enter, the assumption JPS's jump-distance shortcut relies on instead of
+// consulting board values per step.
+func (a *Grid) boardIsUniformCost() bool {
+	for _, row := range a.board {
+		for _, cell := range row {
+			if cell != Impassable && cell != 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Randomize generates a random board with uniform cost-1 walkable cells and
+// picks random start/end points among them.
+func (a *Grid) Randomize(width, height int) error {
+	return a.randomize(width, height, func() int { return 1 })
+}
+
+// RandomizeWeighted behaves like Randomize but assigns each walkable cell a
+// random integer cost in [1, maxCost], for exercising terrain like roads or
+// swamps instead of a uniform cost. As with SetBoard, a maxCost above 1
+// disables JumpPointSearch.
+func (a *Grid) RandomizeWeighted(width, height, maxCost int) error {
+	return a.randomize(width, height, func() int { return 1 + rand.IntN(maxCost) })
+}
+
+func (a *Grid) randomize(width, height int, cost func() int) error {
+	grid := make([][]int, height)
+	walkable := make([][2]int, 0)
+
+	for i := range grid {
+		grid[i] = make([]int, width)
+		for j := range grid[i] {
+			if rand.Float64() < 0.2 {
+				grid[i][j] = Impassable
+			} else {
+				grid[i][j] = cost()
+				walkable = append(walkable, [2]int{i, j})
+			}
+		}
+	}
+	a.SetBoard(grid)
+	if len(walkable) < 2 {
+		return fmt.Errorf("not enough walkable cells found on the board")
+	}
+	randomIndex1 := rand.IntN(len(walkable))
+
+	loc1 := walkable[randomIndex1]
+	walkable = append(walkable[:randomIndex1], walkable[randomIndex1+1:]...)
+	a.SetStart(Point{
+		X: loc1[1],
+		Y: loc1[0],
+	})
+
+	randomIndex2 := rand.IntN(len(walkable))
+	loc2 := walkable[randomIndex2]
+	a.SetEnd(Point{
+		X: loc2[1],
+		Y: loc2[0],
+	})
+	a.SetHeuristic(ManhattanDistance)
+	return nil
+}
+
+func (a *Grid) PrettyPrint(path []Point) {
+
+	fmt.Println(path)
+	for i, row := range a.board {
+		// i = y
+		for j := range row {
+
+			// j = x
+			if a.start.X == j && a.start.Y == i {
+				fmt.Print("S ")
+			} else if slices.Contains(a.ends, Point{j, i}) {
+				fmt.Print("E ")
+			} else if slices.Contains(path, Point{j, i}) {
+				fmt.Print("X ")
+			} else if a.board[i][j] == Impassable {
+				fmt.Print("# ")
+			} else {
+				fmt.Printf("%d ", a.board[i][j])
+			}
+		}
+		fmt.Println()
+	}
+}
+
+func (a *Grid) RawPath(path []Point) {
+	if len(path) == 0 {
+		fmt.Println("No path.")
+		return
+	}
+	for _, p := range path {
+		fmt.Printf("(%d, %d) -> ", p.X, p.Y)
+	}
+	fmt.Println("Goal")
+}
+
+// FindPath runs A* from the Grid's start to its end(s), returning the path,
+// its total cost, and whether a goal was actually reached. With multiple
+// goals set via SetEnds, it returns the shortest path to whichever is
+// reached first. If MaxCost is set and every goal is out of budget, it
+// returns the best-effort path to the closest reachable cell instead, with
+// found set to false.
+func (a *Grid) FindPath() ([]Point, float64, bool) {
+	if a.jumpPointSearchEnabled() {
+		return a.findPathJPS()
+	}
+
+	start := GridPather{grid: a, point: a.start}
+	ends := make([]Pather, len(a.ends))
+	for i, end := range a.ends {
+		ends[i] = GridPather{grid: a, point: end}
+	}
+
+	pathers, cost, found := FindPathAny(start, ends, a.maxCost)
+
+	path := make([]Point, len(pathers))
+	for i, p := range pathers {
+		path[i] = p.(GridPather).point
+	}
+	return path, cost, found
+}
+
+// FindPathBidirectional is the Grid-level counterpart to the package
+// function of the same name: it searches forward from Start and backward
+// from End at the same time, which proves End is unreachable faster than
+// FindPath when it sits in a small enclosed region of the board. It only
+// supports a single goal; with multiple goals set via SetEnds, it searches
+// toward the first. If no end has been set, it reports found as false the
+// same way FindPath does rather than panicking.
+func (a *Grid) FindPathBidirectional() ([]Point, float64, bool) {
+	if len(a.ends) == 0 {
+		return []Point{a.start}, 0, false
+	}
+
+	start := GridPather{grid: a, point: a.start}
+	end := GridPather{grid: a, point: a.ends[0]}
+
+	pathers, cost, found := FindPathBidirectional(start, end)
+
+	path := make([]Point, len(pathers))
+	for i, p := range pathers {
+		path[i] = p.(GridPather).point
+	}
+	return path, cost, found
+}
+
+func (a *Grid) inBounds(p Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < len(a.board[0]) && p.Y < len(a.board)
+}
+
+// blocked reports whether p is out of bounds or Impassable.
+func (a *Grid) blocked(p Point) bool {
+	return !a.inBounds(p) || a.board[p.Y][p.X] == Impassable
+}
@@ -0,0 +1,170 @@
+package astar
+
+import (
+	"math"
+	"testing"
+)
+
+// checkerboardGrid returns a grid where the only route from corner to corner
+// is a chain of diagonal steps through the gaps of a checkerboard wall -
+// unreachable with FourConnected movement, and unreachable with
+// EightConnected movement unless corner cutting is allowed, since every
+// diagonal step has a wall on both orthogonally adjacent cells.
+func checkerboardGrid() *Grid {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, Impassable, 1},
+		{Impassable, 1, Impassable},
+		{1, Impassable, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{2, 2})
+	return g
+}
+
+func TestFourConnectedCannotCrossCheckerboard(t *testing.T) {
+	g := checkerboardGrid()
+	g.SetHeuristic(ManhattanDistance)
+
+	if _, _, found := g.FindPath(); found {
+		t.Fatal("expected no orthogonal path through a checkerboard wall")
+	}
+}
+
+func TestEightConnectedRequiresCornerCutting(t *testing.T) {
+	g := checkerboardGrid()
+	g.SetMovement(EightConnected)
+	g.SetHeuristic(OctileDistance)
+
+	if _, _, found := g.FindPath(); found {
+		t.Fatal("expected corner cutting to be forbidden by default")
+	}
+
+	g.SetAllowCornerCutting(true)
+	path, _, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a diagonal path once corner cutting is allowed")
+	}
+	if len(path) != 3 {
+		t.Fatalf("got path of length %d, want 3: %v", len(path), path)
+	}
+}
+
+func TestChebyshevDistanceMatchesKingMoveCount(t *testing.T) {
+	if got := ChebyshevDistance(Point{0, 0}, Point{3, 1}); got != 3 {
+		t.Errorf("ChebyshevDistance = %v, want 3", got)
+	}
+}
+
+// TestChessKingAvoidsCostlyBarrierTiles reproduces the Rosetta Code "A*
+// search algorithm" task: a chess king (EightConnected movement) crosses a
+// board where a block of "barrier" tiles cost 100 to enter rather than
+// being impassable, so the optimal path detours around the block along the
+// free rim instead of paying the toll through it.
+func TestChessKingAvoidsCostlyBarrierTiles(t *testing.T) {
+	const barrier = 100
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1},
+		{1, barrier, barrier, barrier, 1},
+		{1, barrier, 1, barrier, 1},
+		{1, barrier, barrier, barrier, 1},
+		{1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{0, 2})
+	g.SetEnd(Point{4, 2})
+	g.SetMovement(EightConnected)
+	g.SetHeuristic(ChebyshevDistance)
+
+	path, cost, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a path around the barrier")
+	}
+	if want := 4 + 2*math.Sqrt2; cost != want {
+		t.Fatalf("cost = %v, want %v (around the rim, no barrier tile entered)", cost, want)
+	}
+	for _, p := range path[1 : len(path)-1] {
+		if g.board[p.Y][p.X] == barrier {
+			t.Fatalf("path %v enters a cost-%d barrier tile when a free route around the rim exists", path, barrier)
+		}
+	}
+}
+
+// TestMaxCostFallsBackToClosestReachableCell covers the "click an
+// unreachable island" case: the goal lies farther away than the budget
+// allows, so FindPath should walk as close as the budget allows.
+func TestMaxCostFallsBackToClosestReachableCell(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{4, 0})
+	g.SetHeuristic(ManhattanDistance)
+	g.SetMaxCost(2)
+
+	path, cost, found := g.FindPath()
+	if found {
+		t.Fatal("expected the goal to be out of budget")
+	}
+	if cost != 2 {
+		t.Fatalf("cost = %v, want 2", cost)
+	}
+	want := Point{2, 0}
+	if got := path[len(path)-1]; got != want {
+		t.Fatalf("best-effort path ends at %v, want %v", got, want)
+	}
+}
+
+// TestWeightedTerrainPrefersCheaperRoute checks that a path avoids a costly
+// swamp tile in favor of a longer but cheaper route around it.
+func TestWeightedTerrainPrefersCheaperRoute(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1},
+		{1, 10, 1},
+		{1, 1, 1},
+	})
+	g.SetStart(Point{0, 1})
+	g.SetEnd(Point{2, 1})
+	g.SetHeuristic(ManhattanDistance)
+
+	path, cost, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a path")
+	}
+	if cost != 4 {
+		t.Fatalf("cost = %v, want 4 (around the swamp, not through it)", cost)
+	}
+	for _, p := range path {
+		if p == (Point{1, 1}) {
+			t.Fatalf("path %v cuts through the swamp tile", path)
+		}
+	}
+}
+
+// TestCostFuncOverridesBoardCost checks that a custom CostFunc, such as a
+// directional uphill/downhill penalty, takes precedence over board values.
+func TestCostFuncOverridesBoardCost(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{2, 0})
+	g.SetHeuristic(ManhattanDistance)
+	g.SetCostFunc(func(from, to Point) float64 {
+		if to.X > from.X {
+			return 5 // uphill
+		}
+		return 1
+	})
+
+	_, cost, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a path")
+	}
+	if cost != 10 {
+		t.Fatalf("cost = %v, want 10 for two uphill steps", cost)
+	}
+}
@@ -0,0 +1,213 @@
+package astar
+
+import (
+	"container/heap"
+	"math"
+)
+
+// jpsDirections are the eight unit step directions a start node considers;
+// later nodes instead use prunedDirections, which is what makes Jump Point
+// Search shrink the open list compared to expanding every neighbor.
+var jpsDirections = []Point{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{1, 1}, {1, -1}, {-1, 1}, {-1, -1},
+}
+
+// findPathJPS runs Jump Point Search from a.start to its single goal,
+// returning the path, its cost, and whether the goal was reached. Computing
+// the pruned neighbor directions for a node requires knowing the direction it was
+// reached from, which the generic Pather interface has no way to pass in -
+// so unlike FindPath, this searches the Grid directly and reads that
+// direction off current.parent instead of going through PathNeighbors.
+func (a *Grid) findPathJPS() ([]Point, float64, bool) {
+	start := GridPather{grid: a, point: a.start}
+
+	nodes := nodesPool.Get().(map[Pather]*node)
+	closedSet := closedSetPool.Get().(map[Pather]bool)
+	openList := openListPool.Get().(*priorityQueue)
+	*openList = (*openList)[:0]
+
+	startNode := acquireNode(start, 0, a.heuristicFunc(a.start, a.ends[0]), nil)
+	nodes[start] = startNode
+	heap.Push(openList, startNode)
+
+	defer func() {
+		for _, n := range nodes {
+			n.reset()
+			nodePool.Put(n)
+		}
+		clear(nodes)
+		nodesPool.Put(nodes)
+		clear(closedSet)
+		closedSetPool.Put(closedSet)
+		*openList = (*openList)[:0]
+		openListPool.Put(openList)
+	}()
+
+	toPoints := func(n *node) []Point {
+		pathers := reconstructPath(n)
+		path := make([]Point, len(pathers))
+		for i, p := range pathers {
+			path[i] = p.(GridPather).point
+		}
+		return path
+	}
+
+	best := startNode
+	bestH := startNode.priority
+
+	for openList.Len() > 0 {
+		current := heap.Pop(openList).(*node)
+		currentPoint := current.pather.(GridPather).point
+
+		if currentPoint == a.ends[0] {
+			return toPoints(current), current.cost, true
+		}
+		closedSet[current.pather] = true
+
+		if h := a.heuristicFunc(currentPoint, a.ends[0]); h < bestH {
+			bestH = h
+			best = current
+		}
+
+		directions := jpsDirections
+		if current.parent != nil {
+			parentPoint := current.parent.pather.(GridPather).point
+			fromDir := Point{sign(currentPoint.X - parentPoint.X), sign(currentPoint.Y - parentPoint.Y)}
+			directions = prunedDirections(a, currentPoint, fromDir)
+		}
+
+		for _, dir := range directions {
+			jp, ok := jump(a, currentPoint, dir)
+			if !ok {
+				continue
+			}
+			neighbor := GridPather{grid: a, point: jp}
+			if closedSet[neighbor] {
+				continue
+			}
+
+			dx := math.Abs(float64(jp.X - currentPoint.X))
+			dy := math.Abs(float64(jp.Y - currentPoint.Y))
+			stepCost := dx + dy
+			if dx != 0 && dy != 0 {
+				stepCost = dx * math.Sqrt2 // diagonal jumps always have dx == dy
+			}
+			tentativeCost := current.cost + stepCost
+
+			neighborNode, exists := nodes[neighbor]
+			if !exists {
+				neighborNode = acquireNode(neighbor, tentativeCost, tentativeCost+a.heuristicFunc(jp, a.ends[0]), current)
+				nodes[neighbor] = neighborNode
+				heap.Push(openList, neighborNode)
+				continue
+			}
+			if tentativeCost >= neighborNode.cost {
+				continue
+			}
+
+			neighborNode.cost = tentativeCost
+			neighborNode.priority = tentativeCost + a.heuristicFunc(jp, a.ends[0])
+			neighborNode.parent = current
+			heap.Fix(openList, neighborNode.index)
+		}
+	}
+
+	return toPoints(best), best.cost, false
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// prunedDirections returns the natural and forced neighbor directions for a
+// node reached by moving in fromDir, per the standard Jump Point Search
+// neighbor-pruning rules: a direction is only worth searching if continuing
+// straight, or if an adjacent obstacle means it's the only way to reach a
+// cell that going straight would have skipped.
+func prunedDirections(g *Grid, at, fromDir Point) []Point {
+	dx, dy := fromDir.X, fromDir.Y
+	dirs := make([]Point, 0, 3)
+
+	switch {
+	case dx != 0 && dy != 0:
+		dirs = append(dirs, Point{dx, 0}, Point{0, dy}, Point{dx, dy})
+		if g.blocked(Point{at.X - dx, at.Y}) {
+			dirs = append(dirs, Point{-dx, dy})
+		}
+		if g.blocked(Point{at.X, at.Y - dy}) {
+			dirs = append(dirs, Point{dx, -dy})
+		}
+	case dx != 0:
+		dirs = append(dirs, Point{dx, 0})
+		if g.blocked(Point{at.X - dx, at.Y + 1}) {
+			dirs = append(dirs, Point{0, 1}, Point{dx, 1})
+		}
+		if g.blocked(Point{at.X - dx, at.Y - 1}) {
+			dirs = append(dirs, Point{0, -1}, Point{dx, -1})
+		}
+	default:
+		dirs = append(dirs, Point{0, dy})
+		if g.blocked(Point{at.X + 1, at.Y - dy}) {
+			dirs = append(dirs, Point{1, 0}, Point{1, dy})
+		}
+		if g.blocked(Point{at.X - 1, at.Y - dy}) {
+			dirs = append(dirs, Point{-1, 0}, Point{-1, dy})
+		}
+	}
+	return dirs
+}
+
+// jump steps from "from" in direction dir until it hits an obstacle, the
+// grid's end, or a forced neighbor, returning that cell as the jump point.
+// Diagonal jumps recurse into both orthogonal components first, since a
+// jump point reachable only orthogonally still makes the diagonal cell in
+// front of it a jump point.
+func jump(g *Grid, from, dir Point) (Point, bool) {
+	next := Point{from.X + dir.X, from.Y + dir.Y}
+	if g.blocked(next) {
+		return Point{}, false
+	}
+	if dir.X != 0 && dir.Y != 0 && (g.blocked(Point{from.X + dir.X, from.Y}) || g.blocked(Point{from.X, from.Y + dir.Y})) {
+		// Same no-corner-cutting rule as GridPather.PathNeighbors: a diagonal
+		// step is only legal if both orthogonal cells flanking it are open.
+		return Point{}, false
+	}
+	if next == g.ends[0] {
+		return next, true
+	}
+
+	dx, dy := dir.X, dir.Y
+	switch {
+	case dx != 0 && dy != 0:
+		if (!g.blocked(Point{next.X - dx, next.Y + dy}) && g.blocked(Point{next.X - dx, next.Y})) ||
+			(!g.blocked(Point{next.X + dx, next.Y - dy}) && g.blocked(Point{next.X, next.Y - dy})) {
+			return next, true
+		}
+		if _, ok := jump(g, next, Point{dx, 0}); ok {
+			return next, true
+		}
+		if _, ok := jump(g, next, Point{0, dy}); ok {
+			return next, true
+		}
+	case dx != 0:
+		if (!g.blocked(Point{next.X, next.Y + 1}) && g.blocked(Point{next.X - dx, next.Y + 1})) ||
+			(!g.blocked(Point{next.X, next.Y - 1}) && g.blocked(Point{next.X - dx, next.Y - 1})) {
+			return next, true
+		}
+	default:
+		if (!g.blocked(Point{next.X + 1, next.Y}) && g.blocked(Point{next.X + 1, next.Y - dy})) ||
+			(!g.blocked(Point{next.X - 1, next.Y}) && g.blocked(Point{next.X - 1, next.Y - dy})) {
+			return next, true
+		}
+	}
+
+	return jump(g, next, dir)
+}
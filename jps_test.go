@@ -0,0 +1,125 @@
+package astar
+
+import (
+	"math"
+	"testing"
+)
+
+func jpsComparisonGrid() *Grid {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1, 1, 1, 1},
+		{1, 1, Impassable, Impassable, Impassable, 1, 1, 1},
+		{1, 1, 1, 1, Impassable, 1, 1, 1},
+		{1, Impassable, Impassable, 1, Impassable, 1, 1, 1},
+		{1, 1, 1, 1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{7, 4})
+	g.SetMovement(EightConnected)
+	g.SetHeuristic(OctileDistance)
+	return g
+}
+
+func TestJumpPointSearchMatchesNaiveCost(t *testing.T) {
+	naive := jpsComparisonGrid()
+	_, wantCost, wantFound := naive.FindPath()
+
+	jps := jpsComparisonGrid()
+	jps.SetUseJumpPointSearch(true)
+	path, gotCost, gotFound := jps.FindPath()
+
+	if gotFound != wantFound {
+		t.Fatalf("found = %v, want %v", gotFound, wantFound)
+	}
+	if gotCost != wantCost {
+		t.Fatalf("cost = %v, want %v", gotCost, wantCost)
+	}
+	if path[0] != jps.start || path[len(path)-1] != jps.ends[0] {
+		t.Fatalf("path %v doesn't span start to end", path)
+	}
+}
+
+func TestJumpPointSearchIgnoredWithoutEightConnected(t *testing.T) {
+	g := jpsComparisonGrid()
+	g.SetMovement(FourConnected)
+	g.SetUseJumpPointSearch(true)
+
+	if g.jumpPointSearchEnabled() {
+		t.Fatal("JPS should require EightConnected movement")
+	}
+}
+
+func TestJumpPointSearchIgnoredWithCornerCutting(t *testing.T) {
+	g := jpsComparisonGrid()
+	g.SetUseJumpPointSearch(true)
+	g.SetAllowCornerCutting(true)
+
+	if g.jumpPointSearchEnabled() {
+		t.Fatal("JPS should require corner cutting to be forbidden")
+	}
+}
+
+// TestJumpPointSearchIgnoredWithWeightedTerrain guards against JPS's
+// jump-distance shortcut silently cutting through costly terrain: its
+// stepCost only looks at dx/dy, never board values, so it must be disabled
+// on any board where a walkable cell costs more than 1 rather than route
+// around it like FindPath does.
+func TestJumpPointSearchIgnoredWithWeightedTerrain(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1},
+		{1, 50, 1},
+		{1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{2, 2})
+	g.SetMovement(EightConnected)
+	g.SetHeuristic(OctileDistance)
+	g.SetUseJumpPointSearch(true)
+
+	if g.jumpPointSearchEnabled() {
+		t.Fatal("JPS should require a uniform-cost board")
+	}
+
+	_, cost, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a path")
+	}
+	if want := 2 + math.Sqrt2; cost != want {
+		t.Fatalf("cost = %v, want %v (routing around the swamp tile)", cost, want)
+	}
+}
+
+// TestJumpPointSearchIgnoredWithMaxCost guards against the best-effort
+// MaxCost contract silently breaking under JPS: a jump point is an
+// all-or-nothing run to the next forced neighbor, so it can't stop partway
+// through to honor a budget the way ordinary neighbor expansion can.
+func TestJumpPointSearchIgnoredWithMaxCost(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnd(Point{4, 0})
+	g.SetMovement(EightConnected)
+	g.SetHeuristic(OctileDistance)
+	g.SetUseJumpPointSearch(true)
+	g.SetMaxCost(2)
+
+	if g.jumpPointSearchEnabled() {
+		t.Fatal("JPS should require MaxCost to be unset")
+	}
+
+	path, cost, found := g.FindPath()
+	if found {
+		t.Fatal("expected the goal to be out of budget")
+	}
+	if cost != 2 {
+		t.Fatalf("cost = %v, want 2", cost)
+	}
+	want := Point{2, 0}
+	if got := path[len(path)-1]; got != want {
+		t.Fatalf("best-effort path ends at %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+package astar
+
+import (
+	"container/heap"
+	"math"
+)
+
+// FindPathAny is the search FindPath wraps for its single-goal case: it
+// runs a binary-heap A* search from start whose heuristic at each node is
+// the minimum estimated cost to any of ends, and stops as soon as the first
+// of them is popped off the open list. With multiple ends this is the
+// search for "walk to the nearest door" or "flee to any safe tile" -
+// problems where several destinations are equally acceptable and the
+// closest one should win; the minimum-over-goals heuristic stays admissible
+// as long as each individual PathEstimatedCost does.
+//
+// maxCost caps how far the search expands (0 means unlimited): nodes whose
+// cost from start would exceed it are never added to the open list. If no
+// end is reachable within that budget, FindPathAny falls back to the best
+// path found so far - the path to whichever expanded node had the lowest
+// estimated cost to any end - and reports found as false.
+func FindPathAny(start Pather, ends []Pather, maxCost float64) ([]Pather, float64, bool) {
+	estimate := func(p Pather) float64 {
+		best := math.Inf(1)
+		for _, end := range ends {
+			if c := p.PathEstimatedCost(end); c < best {
+				best = c
+			}
+		}
+		return best
+	}
+	atEnd := func(p Pather) bool {
+		for _, end := range ends {
+			if p == end {
+				return true
+			}
+		}
+		return false
+	}
+
+	nodes := nodesPool.Get().(map[Pather]*node)
+	closedSet := closedSetPool.Get().(map[Pather]bool)
+
+	openList := openListPool.Get().(*priorityQueue)
+	*openList = (*openList)[:0]
+
+	startNode := acquireNode(start, 0, estimate(start), nil)
+	nodes[start] = startNode
+	heap.Push(openList, startNode)
+
+	best := startNode
+	bestH := estimate(start)
+
+	defer func() {
+		for _, n := range nodes {
+			n.reset()
+			nodePool.Put(n)
+		}
+		clear(nodes)
+		nodesPool.Put(nodes)
+		clear(closedSet)
+		closedSetPool.Put(closedSet)
+		*openList = (*openList)[:0]
+		openListPool.Put(openList)
+	}()
+
+	for openList.Len() > 0 {
+		current := heap.Pop(openList).(*node)
+
+		if atEnd(current.pather) {
+			return reconstructPath(current), current.cost, true
+		}
+		closedSet[current.pather] = true
+
+		if h := estimate(current.pather); h < bestH {
+			bestH = h
+			best = current
+		}
+
+		for _, neighbor := range current.pather.PathNeighbors() {
+			if closedSet[neighbor] {
+				continue
+			}
+
+			tentativeCost := current.cost + current.pather.PathNeighborCost(neighbor)
+			if maxCost > 0 && tentativeCost > maxCost {
+				continue
+			}
+
+			neighborNode, exists := nodes[neighbor]
+			if !exists {
+				neighborNode = acquireNode(neighbor, tentativeCost, tentativeCost+estimate(neighbor), current)
+				nodes[neighbor] = neighborNode
+				heap.Push(openList, neighborNode)
+				continue
+			}
+			if tentativeCost >= neighborNode.cost {
+				continue
+			}
+
+			neighborNode.cost = tentativeCost
+			neighborNode.priority = tentativeCost + estimate(neighbor)
+			neighborNode.parent = current
+			heap.Fix(openList, neighborNode.index)
+		}
+	}
+	return reconstructPath(best), best.cost, false
+}
@@ -0,0 +1,44 @@
+package astar
+
+import "testing"
+
+// TestFindPathPrefersNearestOfMultipleEnds checks that with several goals
+// set via SetEnds, FindPath reaches the closest one rather than whichever
+// is listed first.
+func TestFindPathPrefersNearestOfMultipleEnds(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, 1, 1, 1, 1},
+	})
+	g.SetStart(Point{2, 0})
+	g.SetEnds([]Point{{4, 0}, {0, 0}, {3, 0}})
+	g.SetHeuristic(ManhattanDistance)
+
+	path, cost, found := g.FindPath()
+	if !found {
+		t.Fatal("expected a path")
+	}
+	if cost != 1 {
+		t.Fatalf("cost = %v, want 1 (the nearest goal)", cost)
+	}
+	want := Point{3, 0}
+	if got := path[len(path)-1]; got != want {
+		t.Fatalf("path ends at %v, want nearest goal %v", got, want)
+	}
+}
+
+// TestFindPathNoPathToAnyEnd checks that FindPath reports found as false
+// when none of several goals is reachable.
+func TestFindPathNoPathToAnyEnd(t *testing.T) {
+	g := &Grid{}
+	g.SetBoard([][]int{
+		{1, Impassable, 1, Impassable, 1},
+	})
+	g.SetStart(Point{0, 0})
+	g.SetEnds([]Point{{2, 0}, {4, 0}})
+	g.SetHeuristic(ManhattanDistance)
+
+	if _, _, found := g.FindPath(); found {
+		t.Fatal("expected both goals to be unreachable")
+	}
+}
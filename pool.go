@@ -0,0 +1,48 @@
+package astar
+
+import "sync"
+
+// nodePool, openListPool, closedSetPool, and nodesPool recycle the
+// per-search allocations FindPath would otherwise make on every call, which
+// matters for games issuing thousands of searches per second.
+var nodePool = sync.Pool{
+	New: func() any { return new(node) },
+}
+
+var openListPool = sync.Pool{
+	New: func() any { return new(priorityQueue) },
+}
+
+var closedSetPool = sync.Pool{
+	New: func() any { return make(map[Pather]bool) },
+}
+
+// nodesPool recycles the map FindPath uses to look up the *node for a
+// Pather it has already visited, indexed separately from closedSetPool
+// because a node can be in nodes (open or closed) while closedSet only
+// holds closed ones.
+var nodesPool = sync.Pool{
+	New: func() any { return make(map[Pather]*node) },
+}
+
+// acquireNode gets a node from nodePool and populates it, avoiding an
+// allocation for every node FindPath visits.
+func acquireNode(pather Pather, cost, priority float64, parent *node) *node {
+	n := nodePool.Get().(*node)
+	n.pather = pather
+	n.cost = cost
+	n.priority = priority
+	n.parent = parent
+	n.index = -1
+	return n
+}
+
+// reset clears a node's references before it is returned to nodePool, so the
+// pool doesn't keep a finished search's Pathers and parent chain reachable.
+func (n *node) reset() {
+	n.pather = nil
+	n.cost = 0
+	n.priority = 0
+	n.parent = nil
+	n.index = -1
+}
@@ -0,0 +1,34 @@
+package astar
+
+// priorityQueue is a binary min-heap of *node ordered by priority (f = g +
+// h), implementing container/heap.Interface so FindPath can pop the
+// lowest-priority node and decrease-key existing ones in O(log n).
+type priorityQueue []*node
+
+func (pq priorityQueue) Len() int { return len(pq) }
+
+func (pq priorityQueue) Less(i, j int) bool {
+	return pq[i].priority < pq[j].priority
+}
+
+func (pq priorityQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+
+func (pq *priorityQueue) Push(x any) {
+	n := x.(*node)
+	n.index = len(*pq)
+	*pq = append(*pq, n)
+}
+
+func (pq *priorityQueue) Pop() any {
+	old := *pq
+	last := len(old) - 1
+	n := old[last]
+	old[last] = nil
+	n.index = -1
+	*pq = old[:last]
+	return n
+}